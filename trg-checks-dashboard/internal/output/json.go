@@ -0,0 +1,108 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package output
+
+import (
+	"encoding/json"
+
+	"github.com/eclipse-tractusx/sig-release/trg-checks-dashboard/internal/templating"
+)
+
+// jsonSchemaVersion is bumped whenever a field is renamed or removed from the
+// JSON report; additive changes (new optional fields) don't require a bump.
+const jsonSchemaVersion = "1.0"
+
+// JSONReport is the stable, external JSON representation of a CheckProducts
+// run, decoupled from the internal templating types so their fields can
+// evolve independently of the schema consumers (dashboards, CI annotations)
+// rely on.
+type JSONReport struct {
+	SchemaVersion  string           `json:"schemaVersion"`
+	Products       []JSONProduct    `json:"products"`
+	UnhandledRepos []JSONRepository `json:"unhandledRepos"`
+}
+
+type JSONProduct struct {
+	Name          string            `json:"name"`
+	LeadingRepo   string            `json:"leadingRepo"`
+	OverallPassed bool              `json:"overallPassed"`
+	Repositories  []JSONCheckedRepo `json:"repositories"`
+}
+
+type JSONCheckedRepo struct {
+	RepoName            string          `json:"repoName"`
+	RepoUrl             string          `json:"repoUrl"`
+	PassedAllGuidelines bool            `json:"passedAllGuidelines"`
+	GuidelineChecks     []JSONGuideline `json:"guidelineChecks"`
+}
+
+type JSONGuideline struct {
+	GuidelineName    string   `json:"guidelineName"`
+	GuidelineUrl     string   `json:"guidelineUrl"`
+	Passed           bool     `json:"passed"`
+	Optional         bool     `json:"optional"`
+	ErrorDescription string   `json:"errorDescription,omitempty"`
+	Locations        []string `json:"locations,omitempty"`
+}
+
+type JSONRepository struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// JSON renders products and unhandledRepos, as returned by
+// templating.CheckProducts, into the stable JSON report schema.
+func JSON(products []templating.CheckedProduct, unhandledRepos []templating.Repository) ([]byte, error) {
+	report := JSONReport{SchemaVersion: jsonSchemaVersion}
+
+	for _, p := range products {
+		jsonProduct := JSONProduct{Name: p.Name, LeadingRepo: p.LeadingRepo, OverallPassed: p.OverallPassed}
+
+		for _, r := range p.CheckedRepositories {
+			jsonRepo := JSONCheckedRepo{RepoName: r.RepoName, RepoUrl: r.RepoUrl, PassedAllGuidelines: r.PassedAllGuidelines}
+
+			for _, c := range r.GuidelineChecks {
+				var locations []string
+				for _, l := range c.Locations {
+					locations = append(locations, l.Path)
+				}
+
+				jsonRepo.GuidelineChecks = append(jsonRepo.GuidelineChecks, JSONGuideline{
+					GuidelineName:    c.GuidelineName,
+					GuidelineUrl:     c.GuidelineUrl,
+					Passed:           c.Passed,
+					Optional:         c.Optional,
+					ErrorDescription: c.ErrorDescription,
+					Locations:        locations,
+				})
+			}
+
+			jsonProduct.Repositories = append(jsonProduct.Repositories, jsonRepo)
+		}
+
+		report.Products = append(report.Products, jsonProduct)
+	}
+
+	for _, r := range unhandledRepos {
+		report.UnhandledRepos = append(report.UnhandledRepos, JSONRepository{Name: r.Name, URL: r.URL})
+	}
+
+	return json.MarshalIndent(report, "", "  ")
+}
@@ -0,0 +1,117 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/eclipse-tractusx/sig-release/trg-checks-dashboard/internal/templating"
+)
+
+func TestJSON_RendersProductsAndUnhandledRepos(t *testing.T) {
+	products := []templating.CheckedProduct{
+		{
+			Name:          "sig-release",
+			LeadingRepo:   "eclipse-tractusx/sig-release",
+			OverallPassed: false,
+			CheckedRepositories: []templating.CheckedRepository{
+				{
+					RepoName:            "sig-release",
+					RepoUrl:             "https://github.com/eclipse-tractusx/sig-release",
+					PassedAllGuidelines: false,
+					GuidelineChecks: []templating.GuidelineCheck{
+						{
+							GuidelineName:    "TRG 1.02 INSTALL",
+							GuidelineUrl:     "https://example.com/trg-1-02",
+							Passed:           false,
+							ErrorDescription: "INSTALL.md is missing",
+							Locations:        []templating.Location{{Path: "INSTALL.md"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	unhandled := []templating.Repository{{Name: "no-metadata-repo", URL: "https://github.com/eclipse-tractusx/no-metadata-repo"}}
+
+	raw, err := JSON(products, unhandled)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var report JSONReport
+	if err := json.Unmarshal(raw, &report); err != nil {
+		t.Fatalf("rendered JSON did not round-trip: %v", err)
+	}
+
+	if report.SchemaVersion != jsonSchemaVersion {
+		t.Errorf("expected schemaVersion %q, got %q", jsonSchemaVersion, report.SchemaVersion)
+	}
+	if len(report.Products) != 1 || report.Products[0].Name != "sig-release" {
+		t.Fatalf("unexpected products: %+v", report.Products)
+	}
+
+	repo := report.Products[0].Repositories[0]
+	if repo.RepoName != "sig-release" || repo.PassedAllGuidelines {
+		t.Fatalf("unexpected repository: %+v", repo)
+	}
+
+	check := repo.GuidelineChecks[0]
+	if check.ErrorDescription != "INSTALL.md is missing" {
+		t.Errorf("expected error description to round-trip, got %q", check.ErrorDescription)
+	}
+	if len(check.Locations) != 1 || check.Locations[0] != "INSTALL.md" {
+		t.Errorf("expected locations to round-trip as plain paths, got %+v", check.Locations)
+	}
+
+	if len(report.UnhandledRepos) != 1 || report.UnhandledRepos[0].Name != "no-metadata-repo" {
+		t.Fatalf("unexpected unhandled repos: %+v", report.UnhandledRepos)
+	}
+}
+
+func TestJSON_OmitsEmptyOptionalFields(t *testing.T) {
+	products := []templating.CheckedProduct{
+		{
+			Name: "sig-release",
+			CheckedRepositories: []templating.CheckedRepository{
+				{RepoName: "sig-release", GuidelineChecks: []templating.GuidelineCheck{{GuidelineName: "TRG 1.01 README", Passed: true}}},
+			},
+		},
+	}
+
+	raw, err := JSON(products, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var asMap map[string]any
+	if err := json.Unmarshal(raw, &asMap); err != nil {
+		t.Fatalf("rendered JSON did not parse: %v", err)
+	}
+
+	checks := asMap["products"].([]any)[0].(map[string]any)["repositories"].([]any)[0].(map[string]any)["guidelineChecks"].([]any)[0].(map[string]any)
+	if _, present := checks["errorDescription"]; present {
+		t.Error("expected empty errorDescription to be omitted")
+	}
+	if _, present := checks["locations"]; present {
+		t.Error("expected empty locations to be omitted")
+	}
+}
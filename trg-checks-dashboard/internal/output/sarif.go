@@ -0,0 +1,153 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+// Package output serializes quality check results into formats consumable by
+// dashboards, PR annotations and code-scanning UIs.
+package output
+
+import (
+	"github.com/eclipse-tractusx/sig-release/trg-checks-dashboard/internal/templating"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+	toolName     = "tractusx-quality-checks"
+)
+
+// SarifLog is the root object of a SARIF 2.1.0 log file.
+type SarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool    SarifTool     `json:"tool"`
+	Results []SarifResult `json:"results"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []SarifRule `json:"rules,omitempty"`
+}
+
+type SarifRule struct {
+	ID      string `json:"id"`
+	HelpURI string `json:"helpUri,omitempty"`
+}
+
+type SarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SarifMessage    `json:"message"`
+	Locations []SarifLocation `json:"locations,omitempty"`
+}
+
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+}
+
+type SarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIF renders checked products as a SARIF 2.1.0 log, with one result per
+// failed guideline check. ruleId is the guideline name, helpUri its
+// ExternalDescription, and locations are populated only for checks that
+// implement LocatableGuideline; other results carry no location.
+func SARIF(products []templating.CheckedProduct) SarifLog {
+	rulesSeen := make(map[string]bool)
+	var rules []SarifRule
+	var results []SarifResult
+
+	for _, product := range products {
+		for _, repo := range product.CheckedRepositories {
+			for _, check := range repo.GuidelineChecks {
+				if !rulesSeen[check.GuidelineName] {
+					rulesSeen[check.GuidelineName] = true
+					rules = append(rules, SarifRule{ID: check.GuidelineName, HelpURI: check.GuidelineUrl})
+				}
+
+				if check.Passed {
+					continue
+				}
+
+				level := "error"
+				if check.Optional {
+					level = "warning"
+				}
+
+				results = append(results, SarifResult{
+					RuleID:    check.GuidelineName,
+					Level:     level,
+					Message:   SarifMessage{Text: messageFor(repo.RepoName, check)},
+					Locations: sarifLocationsFor(check),
+				})
+			}
+		}
+	}
+
+	return SarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []SarifRun{
+			{
+				Tool:    SarifTool{Driver: SarifDriver{Name: toolName, Rules: rules}},
+				Results: results,
+			},
+		},
+	}
+}
+
+func messageFor(repoName string, check templating.GuidelineCheck) string {
+	if check.ErrorDescription != "" {
+		return check.ErrorDescription
+	}
+	return check.GuidelineName + " failed for " + repoName
+}
+
+// sarifLocationsFor returns a SARIF location per file the check identified
+// via LocatableGuideline, or nil when the check couldn't narrow the failure
+// down to specific files. A bare repository name is not a valid repo-relative
+// artifact URI, so results with no known location omit Locations entirely
+// rather than pointing code-scanning UIs at a nonexistent file.
+func sarifLocationsFor(check templating.GuidelineCheck) []SarifLocation {
+	if len(check.Locations) == 0 {
+		return nil
+	}
+
+	locations := make([]SarifLocation, 0, len(check.Locations))
+	for _, l := range check.Locations {
+		locations = append(locations, SarifLocation{PhysicalLocation: SarifPhysicalLocation{ArtifactLocation: SarifArtifactLocation{URI: l.Path}}})
+	}
+	return locations
+}
@@ -0,0 +1,109 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/eclipse-tractusx/sig-release/trg-checks-dashboard/internal/templating"
+)
+
+func TestSARIF_OnePassOneFailOneOptionalFail(t *testing.T) {
+	products := []templating.CheckedProduct{
+		{
+			Name:        "sig-release",
+			LeadingRepo: "eclipse-tractusx/sig-release",
+			CheckedRepositories: []templating.CheckedRepository{
+				{
+					RepoName: "sig-release",
+					RepoUrl:  "https://github.com/eclipse-tractusx/sig-release",
+					GuidelineChecks: []templating.GuidelineCheck{
+						{GuidelineName: "TRG 1.01 README", GuidelineUrl: "https://example.com/trg-1-01", Passed: true},
+						{GuidelineName: "TRG 1.02 INSTALL", GuidelineUrl: "https://example.com/trg-1-02", Passed: false, ErrorDescription: "INSTALL.md is missing"},
+						{GuidelineName: "TRG 4.01 Helm", GuidelineUrl: "https://example.com/trg-4-01", Passed: false, Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	log := SARIF(products)
+
+	if log.Version != sarifVersion {
+		t.Errorf("expected version %q, got %q", sarifVersion, log.Version)
+	}
+	if len(log.Runs) != 1 {
+		t.Fatalf("expected exactly one run, got %d", len(log.Runs))
+	}
+
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 3 {
+		t.Fatalf("expected 3 distinct rules (one per guideline), got %d", len(run.Tool.Driver.Rules))
+	}
+	if len(run.Results) != 2 {
+		t.Fatalf("expected 2 results (the 2 failing checks), got %d", len(run.Results))
+	}
+
+	byRule := make(map[string]SarifResult)
+	for _, r := range run.Results {
+		byRule[r.RuleID] = r
+	}
+
+	install, ok := byRule["TRG 1.02 INSTALL"]
+	if !ok {
+		t.Fatal("expected a result for the failed required check")
+	}
+	if install.Level != "error" {
+		t.Errorf("expected required failure to be level error, got %q", install.Level)
+	}
+	if install.Message.Text != "INSTALL.md is missing" {
+		t.Errorf("expected message to use ErrorDescription, got %q", install.Message.Text)
+	}
+	if install.Locations != nil {
+		t.Errorf("expected no locations when the check doesn't implement LocatableGuideline, got %+v", install.Locations)
+	}
+
+	helm, ok := byRule["TRG 4.01 Helm"]
+	if !ok {
+		t.Fatal("expected a result for the failed optional check")
+	}
+	if helm.Level != "warning" {
+		t.Errorf("expected optional failure to be level warning, got %q", helm.Level)
+	}
+}
+
+func TestSARIF_NoFailures(t *testing.T) {
+	products := []templating.CheckedProduct{
+		{
+			Name: "sig-release",
+			CheckedRepositories: []templating.CheckedRepository{
+				{RepoName: "sig-release", GuidelineChecks: []templating.GuidelineCheck{{GuidelineName: "TRG 1.01 README", Passed: true}}},
+			},
+		},
+	}
+
+	log := SARIF(products)
+	if len(log.Runs[0].Results) != 0 {
+		t.Fatalf("expected no results when every check passed, got %d", len(log.Runs[0].Results))
+	}
+	if len(log.Runs[0].Tool.Driver.Rules) != 1 {
+		t.Fatalf("expected the passing check to still be registered as a rule, got %d", len(log.Runs[0].Tool.Driver.Rules))
+	}
+}
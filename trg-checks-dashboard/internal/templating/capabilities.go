@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+// Location identifies a file, relative to the repository root, implicated by
+// a failed guideline check.
+type Location struct {
+	Path string
+}
+
+// LocatableGuideline is an optional capability of a tractusx.QualityGuideline:
+// checks that know which file caused a failure (e.g. Helm structure,
+// Dockerfile base image) implement it so output formats like SARIF can carry
+// a precise artifact location; results of other checks carry no location.
+type LocatableGuideline interface {
+	Locations() []Location
+}
@@ -0,0 +1,127 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// etagCacheEntry is the on-disk representation of a cached GET response,
+// keyed by request URL.
+type etagCacheEntry struct {
+	ETag       string
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// etagCacheTransport caches GET responses on disk by ETag, keyed by request
+// URL, so that unchanged GitHub API resources (repo listings, `.tractusx`
+// contents) can be served from a 304 Not Modified without re-downloading.
+type etagCacheTransport struct {
+	base     http.RoundTripper
+	cacheDir string
+}
+
+func (t *etagCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cacheDir == "" || req.Method != http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+
+	key := etagCacheKey(req.URL.String())
+	cached := loadEtagCacheEntry(t.cacheDir, key)
+	if cached != nil {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body.Close()
+		return cached.toResponse(req), nil
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+
+		entry := etagCacheEntry{ETag: etag, StatusCode: resp.StatusCode, Header: resp.Header, Body: body}
+		saveEtagCacheEntry(t.cacheDir, key, entry)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	return resp, nil
+}
+
+func (e *etagCacheEntry) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		StatusCode: e.StatusCode,
+		Status:     http.StatusText(e.StatusCode),
+		Header:     e.Header,
+		Body:       io.NopCloser(bytes.NewReader(e.Body)),
+		Request:    req,
+	}
+}
+
+func etagCacheKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])
+}
+
+func loadEtagCacheEntry(cacheDir, key string) *etagCacheEntry {
+	f, err := os.Open(filepath.Join(cacheDir, key))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var entry etagCacheEntry
+	if err := gob.NewDecoder(f).Decode(&entry); err != nil {
+		return nil
+	}
+	return &entry
+}
+
+func saveEtagCacheEntry(cacheDir, key string, entry etagCacheEntry) {
+	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+		return
+	}
+
+	f, err := os.Create(filepath.Join(cacheDir, key))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	_ = gob.NewEncoder(f).Encode(entry)
+}
@@ -0,0 +1,117 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+)
+
+// roundTripFunc lets a plain function satisfy http.RoundTripper.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestEtagCacheTransport_ServesFromCacheOn304(t *testing.T) {
+	cacheDir := t.TempDir()
+	requests := 0
+
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		if requests == 1 {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"ETag": []string{`"v1"`}},
+				Body:       io.NopCloser(bytes.NewBufferString("first response")),
+			}, nil
+		}
+
+		if req.Header.Get("If-None-Match") != `"v1"` {
+			t.Fatalf("expected If-None-Match to be set from cache, got %q", req.Header.Get("If-None-Match"))
+		}
+		return &http.Response{
+			StatusCode: http.StatusNotModified,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewBuffer(nil)),
+		}, nil
+	})
+
+	transport := &etagCacheTransport{base: base, cacheDir: cacheDir}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/repos/foo", nil)
+
+	first, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+	firstBody, _ := io.ReadAll(first.Body)
+	if string(firstBody) != "first response" {
+		t.Fatalf("unexpected first body: %q", firstBody)
+	}
+
+	second, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error on second request: %v", err)
+	}
+	if second.StatusCode != http.StatusOK {
+		t.Fatalf("expected cached response to be surfaced as 200, got %d", second.StatusCode)
+	}
+	secondBody, _ := io.ReadAll(second.Body)
+	if string(secondBody) != "first response" {
+		t.Fatalf("expected cached body %q, got %q", "first response", secondBody)
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected exactly 2 round trips to the base transport, got %d", requests)
+	}
+}
+
+func TestEtagCacheTransport_NonGetBypassesCache(t *testing.T) {
+	requests := 0
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(bytes.NewBuffer(nil))}, nil
+	})
+
+	transport := &etagCacheTransport{base: base, cacheDir: t.TempDir()}
+	req, _ := http.NewRequest(http.MethodPost, "https://api.example.com/repos/foo", nil)
+
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected POST requests to always reach the base transport, got %d calls", requests)
+	}
+}
+
+func TestEtagCacheKeyIsStableAndDistinct(t *testing.T) {
+	if etagCacheKey("https://a") != etagCacheKey("https://a") {
+		t.Fatal("expected the same URL to produce the same cache key")
+	}
+	if etagCacheKey("https://a") == etagCacheKey("https://b") {
+		t.Fatal("expected different URLs to produce different cache keys")
+	}
+}
@@ -0,0 +1,81 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/tractusx"
+)
+
+// filterRepos keeps only the repositories matching cfg.IncludeRepos (if any
+// are given) and drops the ones matching cfg.ExcludeRepos. Entries in either
+// list may be a full repo name (e.g. "eclipse-tractusx/sig-release") or a
+// glob pattern understood by filepath.Match, with "**" supported via
+// doublestar to match across path segments (e.g. "eclipse-tractusx/tractusx-edc-*").
+func filterRepos(repos []tractusx.Repository, includeRepos, excludeRepos []string) []tractusx.Repository {
+	if len(includeRepos) == 0 && len(excludeRepos) == 0 {
+		return repos
+	}
+
+	var filtered []tractusx.Repository
+	for _, r := range repos {
+		name := repoFullName(r)
+
+		if len(includeRepos) > 0 && !matchesAny(includeRepos, name) {
+			continue
+		}
+		if matchesAny(excludeRepos, name) {
+			continue
+		}
+
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
+
+// repoFullName derives "org/repo" from a repository's URL, falling back to
+// its bare name if the URL cannot be parsed.
+func repoFullName(repo tractusx.Repository) string {
+	u, err := url.Parse(repo.Url)
+	if err != nil {
+		return repo.Name
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) < 2 {
+		return repo.Name
+	}
+	return strings.Join(segments[len(segments)-2:], "/")
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if pattern == name {
+			return true
+		}
+		if ok, _ := doublestar.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
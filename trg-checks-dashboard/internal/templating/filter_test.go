@@ -0,0 +1,103 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"testing"
+
+	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/tractusx"
+)
+
+func TestRepoFullName(t *testing.T) {
+	tests := []struct {
+		name string
+		repo tractusx.Repository
+		want string
+	}{
+		{"github url", tractusx.Repository{Name: "sig-release", Url: "https://github.com/eclipse-tractusx/sig-release"}, "eclipse-tractusx/sig-release"},
+		{"trailing slash", tractusx.Repository{Name: "sig-release", Url: "https://github.com/eclipse-tractusx/sig-release/"}, "eclipse-tractusx/sig-release"},
+		{"unparseable url falls back to name", tractusx.Repository{Name: "local-repo", Url: "://local-repo"}, "local-repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := repoFullName(tt.repo); got != tt.want {
+				t.Errorf("repoFullName(%+v) = %q, want %q", tt.repo, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesAny(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		repoName string
+		want     bool
+	}{
+		{"exact match", []string{"eclipse-tractusx/sig-release"}, "eclipse-tractusx/sig-release", true},
+		{"glob match", []string{"eclipse-tractusx/tractusx-edc-*"}, "eclipse-tractusx/tractusx-edc-controlplane", true},
+		{"no match", []string{"eclipse-tractusx/tractusx-edc-*"}, "eclipse-tractusx/sig-release", false},
+		{"doublestar match", []string{"eclipse-tractusx/**"}, "eclipse-tractusx/sig-release", true},
+		{"empty patterns", nil, "eclipse-tractusx/sig-release", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesAny(tt.patterns, tt.repoName); got != tt.want {
+				t.Errorf("matchesAny(%v, %q) = %t, want %t", tt.patterns, tt.repoName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterRepos(t *testing.T) {
+	repos := []tractusx.Repository{
+		{Name: "sig-release", Url: "https://github.com/eclipse-tractusx/sig-release"},
+		{Name: "tractusx-edc-controlplane", Url: "https://github.com/eclipse-tractusx/tractusx-edc-controlplane"},
+		{Name: "archived-thing", Url: "https://github.com/eclipse-tractusx/archived-thing"},
+	}
+
+	t.Run("no filters returns all", func(t *testing.T) {
+		got := filterRepos(repos, nil, nil)
+		if len(got) != len(repos) {
+			t.Fatalf("expected %d repos, got %d", len(repos), len(got))
+		}
+	})
+
+	t.Run("include narrows to matching glob", func(t *testing.T) {
+		got := filterRepos(repos, []string{"eclipse-tractusx/tractusx-edc-*"}, nil)
+		if len(got) != 1 || got[0].Name != "tractusx-edc-controlplane" {
+			t.Fatalf("unexpected filtered repos: %+v", got)
+		}
+	})
+
+	t.Run("exclude drops matching repo even if included", func(t *testing.T) {
+		got := filterRepos(repos, []string{"eclipse-tractusx/**"}, []string{"eclipse-tractusx/archived-thing"})
+		for _, r := range got {
+			if r.Name == "archived-thing" {
+				t.Fatalf("expected archived-thing to be excluded, got %+v", got)
+			}
+		}
+		if len(got) != 2 {
+			t.Fatalf("expected 2 repos, got %d: %+v", len(got), got)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"log"
+	"time"
+)
+
+// RepoMetrics captures the per-repository timing and size data gathered while
+// running quality checks against it.
+type RepoMetrics struct {
+	RepoName      string
+	CloneDuration time.Duration
+	CheckDuration time.Duration
+	RepoSizeBytes int64
+	Passed        bool
+}
+
+// MetricsSink receives a RepoMetrics for every repository checked. Sinks must
+// be safe for concurrent use, since CheckProducts reports from multiple
+// worker goroutines.
+type MetricsSink interface {
+	Report(metrics RepoMetrics)
+}
+
+// StdoutSink logs each RepoMetrics via the standard logger. It is the default
+// sink used when Config.MetricsSink is not set.
+type StdoutSink struct{}
+
+// NewStdoutSink creates a MetricsSink that logs to the standard logger.
+func NewStdoutSink() MetricsSink {
+	return StdoutSink{}
+}
+
+func (StdoutSink) Report(m RepoMetrics) {
+	log.Printf("repo=%s passed=%t clone=%s check=%s size=%dB", m.RepoName, m.Passed, m.CloneDuration, m.CheckDuration, m.RepoSizeBytes)
+}
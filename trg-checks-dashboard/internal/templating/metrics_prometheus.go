@@ -0,0 +1,75 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusSink records RepoMetrics against a Prometheus registry, for
+// scanners that export metrics rather than (or in addition to) logging them.
+type PrometheusSink struct {
+	cloneDuration *prometheus.HistogramVec
+	checkDuration *prometheus.HistogramVec
+	repoSize      *prometheus.GaugeVec
+	checksTotal   *prometheus.CounterVec
+}
+
+// NewPrometheusSink creates a MetricsSink and registers its collectors with
+// registry.
+func NewPrometheusSink(registry prometheus.Registerer) MetricsSink {
+	sink := &PrometheusSink{
+		cloneDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "tractusx_qc",
+			Name:      "clone_duration_seconds",
+			Help:      "Duration of cloning a repository before running checks.",
+		}, []string{"repo"}),
+		checkDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "tractusx_qc",
+			Name:      "check_duration_seconds",
+			Help:      "Duration of running all quality guidelines against a repository.",
+		}, []string{"repo"}),
+		repoSize: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "tractusx_qc",
+			Name:      "repo_size_bytes",
+			Help:      "Size in bytes of the checked out repository.",
+		}, []string{"repo"}),
+		checksTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "tractusx_qc",
+			Name:      "checks_total",
+			Help:      "Number of repositories checked, by pass/fail outcome.",
+		}, []string{"repo", "outcome"}),
+	}
+
+	registry.MustRegister(sink.cloneDuration, sink.checkDuration, sink.repoSize, sink.checksTotal)
+	return sink
+}
+
+func (s *PrometheusSink) Report(m RepoMetrics) {
+	s.cloneDuration.WithLabelValues(m.RepoName).Observe(m.CloneDuration.Seconds())
+	s.checkDuration.WithLabelValues(m.RepoName).Observe(m.CheckDuration.Seconds())
+	s.repoSize.WithLabelValues(m.RepoName).Set(float64(m.RepoSizeBytes))
+
+	outcome := "fail"
+	if m.Passed {
+		outcome = "pass"
+	}
+	s.checksTotal.WithLabelValues(m.RepoName, outcome).Inc()
+}
@@ -0,0 +1,153 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"log"
+
+	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/tractusx"
+)
+
+// RepoProvider abstracts the source that repositories are listed from, inspected
+// for tractusx metadata and cloned from. It decouples CheckProducts from GitHub
+// so that other forges, or a local filesystem mirror, can be checked the same way.
+type RepoProvider interface {
+	// List returns all repositories this provider knows about.
+	List() ([]tractusx.Repository, error)
+
+	// FetchMetadata retrieves the .tractusx metadata for repo. A nil Metadata
+	// with a nil error means the repository simply does not carry metadata.
+	FetchMetadata(repo tractusx.Repository) (*tractusx.Metadata, error)
+
+	// Clone checks out repo into a local directory and returns its path. Callers
+	// must call Cleanup with the returned path once done with it.
+	Clone(repo Repository) (string, error)
+
+	// Cleanup releases a directory previously returned by Clone. Providers that
+	// hand out a pre-existing directory, such as the local provider, no-op here
+	// rather than deleting the caller's own checkout.
+	Cleanup(dir string) error
+}
+
+// Config configures a CheckProducts run.
+type Config struct {
+	// Providers are the repository sources to check. When empty, CheckProducts
+	// falls back to the public GitHub provider for the eclipse-tractusx
+	// organization.
+	Providers []RepoProvider
+
+	// IncludeRepos, if non-empty, restricts the run to repositories matching
+	// at least one of these full names or glob patterns (e.g.
+	// "eclipse-tractusx/sig-release", "eclipse-tractusx/tractusx-edc-*").
+	IncludeRepos []string
+
+	// ExcludeRepos drops repositories matching any of these full names or
+	// glob patterns, even if they matched IncludeRepos.
+	ExcludeRepos []string
+
+	// MaxConcurrency bounds how many repositories are cloned and checked at
+	// once. Defaults to runtime.NumCPU() when zero or negative.
+	MaxConcurrency int
+
+	// MetricsSink receives per-repository timing and size metrics as checks
+	// complete. Defaults to a StdoutSink when nil.
+	MetricsSink MetricsSink
+}
+
+// CheckProducts checks the repositories returned by cfg.Providers against the
+// Tractus-X quality guidelines and groups them into products by leading
+// repository.
+func CheckProducts(cfg Config) ([]CheckedProduct, []Repository) {
+	providers := cfg.Providers
+	if len(providers) == 0 {
+		providers = []RepoProvider{NewGitHubProvider()}
+	}
+
+	repoInfoByRepoUrl := make(map[string]repoInfo)
+	providerByRepoUrl := make(map[string]RepoProvider)
+	var unhandledRepos []Repository
+
+	for _, provider := range providers {
+		repos, err := provider.List()
+		if err != nil {
+			log.Printf("Could not list repositories from provider: %v", err)
+			continue
+		}
+		repos = filterRepos(repos, cfg.IncludeRepos, cfg.ExcludeRepos)
+
+		for _, repo := range repos {
+			providerByRepoUrl[repo.Url] = provider
+
+			metadata, err := provider.FetchMetadata(repo)
+			if err != nil || metadata == nil {
+				unhandledRepos = append(unhandledRepos, Repository{Name: repo.Name, URL: repo.Url})
+				continue
+			}
+
+			repoInfoByRepoUrl[repo.Url] = repoInfo{metadata: *metadata, repoName: repo.Name, repoUrl: repo.Url}
+		}
+	}
+
+	sink := cfg.MetricsSink
+	if sink == nil {
+		sink = NewStdoutSink()
+	}
+
+	products := getProductsFromMetadata(repoInfoByRepoUrl)
+
+	type job struct {
+		productIndex int
+		repo         Repository
+	}
+	var jobs []job
+	for i, p := range products {
+		for _, r := range p.Repositories {
+			jobs = append(jobs, job{productIndex: i, repo: r})
+		}
+	}
+
+	results := runConcurrently(jobs, cfg.MaxConcurrency, func(j job) CheckedRepository {
+		return runQualityChecks(j.repo, providerByRepoUrl[j.repo.URL], sink)
+	})
+
+	productIndexes := make([]int, len(jobs))
+	for i, j := range jobs {
+		productIndexes[i] = j.productIndex
+	}
+
+	return aggregateCheckedRepos(products, productIndexes, results), unhandledRepos
+}
+
+// aggregateCheckedRepos groups results back into one CheckedProduct per
+// product, where results[i] belongs to the product at productIndexes[i].
+// A product passes overall only if every one of its repositories did.
+func aggregateCheckedRepos(products []Product, productIndexes []int, results []CheckedRepository) []CheckedProduct {
+	checkedProducts := make([]CheckedProduct, len(products))
+	for i, p := range products {
+		checkedProducts[i] = CheckedProduct{Name: p.Name, LeadingRepo: p.LeadingRepo, OverallPassed: true}
+	}
+
+	for i, productIndex := range productIndexes {
+		checkedProducts[productIndex].OverallPassed = checkedProducts[productIndex].OverallPassed && results[i].PassedAllGuidelines
+		checkedProducts[productIndex].CheckedRepositories = append(checkedProducts[productIndex].CheckedRepositories, results[i])
+	}
+
+	return checkedProducts
+}
@@ -0,0 +1,120 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/tractusx"
+)
+
+// giteaProvider lists, inspects and clones the repositories of an
+// organization on a Gitea or Forgejo instance.
+type giteaProvider struct {
+	client *gitea.Client
+	org    string
+}
+
+// NewGiteaProvider creates a RepoProvider for all repositories of org on the
+// Gitea/Forgejo instance reachable at baseURL. A token is read from the
+// GITEA_ACCESS_TOKEN environment variable, if set.
+func NewGiteaProvider(baseURL, org string) (RepoProvider, error) {
+	opts := []gitea.ClientOption{}
+	if token := os.Getenv("GITEA_ACCESS_TOKEN"); token != "" {
+		opts = append(opts, gitea.SetToken(token))
+	}
+
+	client, err := gitea.NewClient(baseURL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create Gitea client: %w", err)
+	}
+	return &giteaProvider{client: client, org: org}, nil
+}
+
+func (p *giteaProvider) List() ([]tractusx.Repository, error) {
+	var result []tractusx.Repository
+
+	page := 1
+	for {
+		repos, _, err := p.client.ListOrgRepos(p.org, gitea.ListOrgReposOptions{
+			ListOptions: gitea.ListOptions{Page: page, PageSize: 50},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not list repositories of org %s: %w", p.org, err)
+		}
+		if len(repos) == 0 {
+			break
+		}
+
+		for _, r := range repos {
+			result = append(result, tractusx.Repository{Name: r.Name, Url: r.HTMLURL})
+		}
+		page++
+	}
+
+	return result, nil
+}
+
+func (p *giteaProvider) FetchMetadata(repo tractusx.Repository) (*tractusx.Metadata, error) {
+	content, _, err := p.client.GetContents(p.org, repo.Name, "", ".tractusx")
+	if err != nil || content.Content == nil {
+		return nil, nil
+	}
+
+	raw := *content.Content
+	if content.Encoding == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(raw)
+		if err != nil {
+			log.Printf("Could not decode .tractusx metadata for repository: %s", repo.Name)
+			return nil, nil
+		}
+		raw = string(decoded)
+	}
+
+	metadata, err := tractusx.MetadataFromFile([]byte(raw))
+	if err != nil {
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+func (p *giteaProvider) Clone(repo Repository) (string, error) {
+	dir, err := os.MkdirTemp("", "tractusx-qc-*")
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.Command("git", "clone", repo.URL, dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("git clone %s failed: %w (%s)", repo.URL, err, out)
+	}
+
+	return dir, nil
+}
+
+func (p *giteaProvider) Cleanup(dir string) error {
+	return os.RemoveAll(dir)
+}
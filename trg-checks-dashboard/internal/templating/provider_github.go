@@ -0,0 +1,329 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/tractusx"
+	"github.com/google/go-github/v53/github"
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultGitHubOrg         = "eclipse-tractusx"
+	defaultMaxRateLimitSleep = 10 * time.Minute
+)
+
+// githubProvider lists, inspects and clones the public repositories of a
+// GitHub organization.
+type githubProvider struct {
+	client          *github.Client
+	org             string
+	shallowClone    bool
+	contentCacheDir string
+}
+
+type githubProviderConfig struct {
+	org             string
+	maxSleep        time.Duration
+	cacheDir        string
+	shallowClone    bool
+	contentCacheDir string
+}
+
+// GitHubOption configures a provider created by NewGitHubProvider.
+type GitHubOption func(*githubProviderConfig)
+
+// WithOrg checks repositories of org instead of the default eclipse-tractusx
+// organization.
+func WithOrg(org string) GitHubOption {
+	return func(c *githubProviderConfig) { c.org = org }
+}
+
+// WithMaxRateLimitSleep bounds how long the provider will sleep when the
+// GitHub API reports it is rate-limited, instead of sleeping until the full
+// reset window.
+func WithMaxRateLimitSleep(d time.Duration) GitHubOption {
+	return func(c *githubProviderConfig) { c.maxSleep = d }
+}
+
+// WithCacheDir enables an on-disk ETag cache for GitHub API responses
+// (repository listings and `.tractusx` contents) under dir, so unchanged
+// resources are served from a 304 Not Modified on re-runs.
+func WithCacheDir(dir string) GitHubOption {
+	return func(c *githubProviderConfig) { c.cacheDir = dir }
+}
+
+// WithShallowClone performs `git clone --depth=1 --filter=blob:none` instead
+// of a full clone, which is enough for checks that only inspect a handful of
+// files at HEAD.
+func WithShallowClone() GitHubOption {
+	return func(c *githubProviderConfig) { c.shallowClone = true }
+}
+
+// WithContentCache skips cloning altogether: Clone instead fetches just the
+// paths declared by requiredCheckPaths over the Contents API and caches them
+// on disk by commit SHA under dir. When dir is empty it defaults to
+// $XDG_CACHE_HOME/tractusx-qc (os.UserCacheDir()+"/tractusx-qc"). Repositories
+// that cannot be resolved this way fall back to a regular clone.
+func WithContentCache(dir string) GitHubOption {
+	if dir == "" {
+		if cacheHome, err := os.UserCacheDir(); err == nil {
+			dir = filepath.Join(cacheHome, "tractusx-qc")
+		}
+	}
+	return func(c *githubProviderConfig) { c.contentCacheDir = dir }
+}
+
+// NewGitHubProvider creates a RepoProvider for the public repositories of a
+// GitHub organization, eclipse-tractusx by default. A GitHub client is
+// authenticated using the GITHUB_ACCESS_TOKEN environment variable, if set,
+// to benefit from the higher authenticated rate limit. The underlying HTTP
+// transport detects primary and secondary rate-limit responses and retries
+// after sleeping, and optionally caches GET responses on disk by ETag.
+func NewGitHubProvider(opts ...GitHubOption) RepoProvider {
+	cfg := githubProviderConfig{org: defaultGitHubOrg, maxSleep: defaultMaxRateLimitSleep}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	transport := http.DefaultTransport
+	if os.Getenv("GITHUB_ACCESS_TOKEN") != "" {
+		transport = &oauth2.Transport{
+			Base:   transport,
+			Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: os.Getenv("GITHUB_ACCESS_TOKEN")}),
+		}
+	}
+	transport = &rateLimitTransport{base: transport, maxSleep: cfg.maxSleep}
+	transport = &etagCacheTransport{base: transport, cacheDir: cfg.cacheDir}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+	return &githubProvider{
+		client:          client,
+		org:             cfg.org,
+		shallowClone:    cfg.shallowClone,
+		contentCacheDir: cfg.contentCacheDir,
+	}
+}
+
+type listFunc[T any] func(ctx context.Context, options *github.ListOptions) ([]T, *github.Response, error)
+
+func paginate[T any](ctx context.Context, listFunc listFunc[T], listOps *github.ListOptions) ([]T, error) {
+	var allItems []T
+
+	for {
+		items, resp, err := listFunc(ctx, listOps)
+		if err != nil {
+			return allItems, err
+		}
+
+		allItems = append(allItems, items...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		listOps.Page = resp.NextPage
+	}
+
+	return allItems, nil
+}
+
+func (p *githubProvider) listOrgRepos(ctx context.Context, listOps *github.ListOptions) ([]*github.Repository, *github.Response, error) {
+	repos, response, err := p.client.Repositories.ListByOrg(ctx, p.org, &github.RepositoryListByOrgOptions{
+		Type:        "public",
+		ListOptions: *listOps})
+	return repos, response, err
+}
+
+func (p *githubProvider) List() ([]tractusx.Repository, error) {
+	repos, err := paginate(context.Background(), p.listOrgRepos, &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []tractusx.Repository
+	for _, r := range repos {
+		result = append(result, tractusx.Repository{Name: *r.Name, Url: *r.HTMLURL})
+	}
+	return result, nil
+}
+
+func (p *githubProvider) FetchMetadata(repo tractusx.Repository) (*tractusx.Metadata, error) {
+	log.Printf("Getting tractusx metadata for repository: %s", repo.Name)
+	contents, _, _, err := p.client.Repositories.GetContents(context.Background(), p.org, repo.Name, ".tractusx", nil)
+	if err != nil {
+		log.Printf("Could not get .tractusx metadata for repository: %s", repo.Name)
+		return nil, nil
+	}
+
+	content, _ := contents.GetContent()
+	metadata, err := tractusx.MetadataFromFile([]byte(content))
+	if err != nil {
+		log.Printf("Could not parse .tractusx metadata for repository: %s", repo.Name)
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+func (p *githubProvider) Clone(repo Repository) (string, error) {
+	if p.contentCacheDir != "" {
+		dir, err := p.fetchRequiredFiles(repo)
+		if err == nil {
+			return dir, nil
+		}
+		log.Printf("Could not fetch files for %s without cloning, falling back to git clone. Error: %s", repo.URL, err)
+	}
+
+	dir, err := os.MkdirTemp("", "tractusx-qc-*")
+	if err != nil {
+		return "", err
+	}
+
+	args := []string{"clone"}
+	if p.shallowClone {
+		args = append(args, "--depth=1", "--filter=blob:none")
+	}
+	args = append(args, repo.URL, dir)
+
+	cmd := exec.Command("git", args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("git clone %s failed: %w (%s)", repo.URL, err, out)
+	}
+
+	return dir, nil
+}
+
+// fetchRequiredFiles fetches only the paths requiredCheckPaths declares over
+// the Contents API, laying them out at the same relative paths under a
+// directory cached by commit SHA, so re-runs against an unchanged repo only
+// resolve the HEAD commit and fetch no file contents. The cache directory is only populated and kept once
+// every required path has been fetched (or confirmed absent from the repo);
+// a partial failure returns an error so Clone falls back to a regular clone
+// instead of silently reusing an incomplete checkout.
+func (p *githubProvider) fetchRequiredFiles(repo Repository) (string, error) {
+	commit, _, err := p.client.Repositories.GetCommit(context.Background(), p.org, repo.Name, "HEAD", nil)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve HEAD commit for %s: %w", repo.Name, err)
+	}
+
+	dir := filepath.Join(p.contentCacheDir, p.org, repo.Name, commit.GetSHA())
+	if info, err := os.Stat(dir); err == nil && info.IsDir() {
+		return dir, nil
+	}
+
+	partialDir := dir + ".partial"
+	if err := os.RemoveAll(partialDir); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(partialDir, 0o755); err != nil {
+		return "", err
+	}
+
+	var failedPaths []string
+	for _, path := range requiredCheckPaths() {
+		if err := p.fetchPathInto(repo.Name, path, commit.GetSHA(), partialDir); err != nil {
+			if isNotFoundError(err) {
+				// Path does not exist in this repo; the checks themselves
+				// tolerate missing files.
+				continue
+			}
+			log.Printf("Could not fetch %s for %s: %s", path, repo.Name, err)
+			failedPaths = append(failedPaths, path)
+		}
+	}
+
+	if len(failedPaths) > 0 {
+		os.RemoveAll(partialDir)
+		return "", fmt.Errorf("could not fetch required paths %v for %s", failedPaths, repo.Name)
+	}
+
+	if err := os.Rename(partialDir, dir); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// fetchPathInto fetches path from repo at ref into dir, at the same relative
+// location. path may be a file or a directory: GetContents returns exactly
+// one of fileContent or dirContent depending on which, and a directory (e.g.
+// a Helm chart's "charts" dir) is walked recursively so nested files such as
+// Chart.yaml and templates/*.yaml are captured too.
+func (p *githubProvider) fetchPathInto(repoName, path, ref, dir string) error {
+	fileContent, dirContent, _, err := p.client.Repositories.GetContents(context.Background(), p.org, repoName, path,
+		&github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		return err
+	}
+
+	if fileContent == nil {
+		for _, entry := range dirContent {
+			if err := p.fetchPathInto(repoName, entry.GetPath(), ref, dir); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return err
+	}
+
+	target := filepath.Join(dir, path)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(target, []byte(content), 0o644)
+}
+
+// isNotFoundError reports whether err is a GitHub API 404, meaning the
+// requested path legitimately does not exist in the repository rather than
+// having failed to fetch.
+func isNotFoundError(err error) bool {
+	var ghErr *github.ErrorResponse
+	if errors.As(err, &ghErr) {
+		return ghErr.Response != nil && ghErr.Response.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+func (p *githubProvider) Cleanup(dir string) error {
+	if p.contentCacheDir != "" && strings.HasPrefix(dir, p.contentCacheDir) {
+		// Cached content is kept on disk for reuse by later runs.
+		return nil
+	}
+	return os.RemoveAll(dir)
+}
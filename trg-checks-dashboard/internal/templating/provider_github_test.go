@@ -0,0 +1,185 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+)
+
+// contentsEntry is the subset of the GitHub Contents API response shape this
+// fake server needs, for both a file and a directory listing entry.
+type contentsEntry struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Content  string `json:"content,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// newFakeGitHubProvider starts an httptest server that fakes just enough of
+// the GitHub API (HEAD commit resolution and Contents) for a githubProvider
+// to fetch files from it, and returns a provider pointed at it.
+func newFakeGitHubProvider(t *testing.T, cacheDir string, contentsByPath map[string]any) *githubProvider {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/widget/commits/HEAD", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&github.RepositoryCommit{SHA: github.String("deadbeef")})
+	})
+	mux.HandleFunc("/repos/acme/widget/contents/", func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path[len("/repos/acme/widget/contents/"):]
+		entry, ok := contentsByPath[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&github.ErrorResponse{Message: "Not Found"})
+			return
+		}
+		json.NewEncoder(w).Encode(entry)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+
+	return &githubProvider{client: client, org: "acme", contentCacheDir: cacheDir}
+}
+
+func fileEntry(path, content string) contentsEntry {
+	return contentsEntry{Type: "file", Name: filepath.Base(path), Path: path, Content: base64.StdEncoding.EncodeToString([]byte(content)), Encoding: "base64"}
+}
+
+func TestFetchRequiredFiles_RecursesIntoDirectoriesAndCachesByCommit(t *testing.T) {
+	cacheDir := t.TempDir()
+	contents := map[string]any{
+		"README.md": fileEntry("README.md", "# widget"),
+		"charts": []contentsEntry{
+			{Type: "dir", Name: "widget", Path: "charts/widget"},
+		},
+		"charts/widget": []contentsEntry{
+			{Type: "file", Name: "Chart.yaml", Path: "charts/widget/Chart.yaml"},
+		},
+		"charts/widget/Chart.yaml": fileEntry("charts/widget/Chart.yaml", "name: widget"),
+	}
+
+	p := newFakeGitHubProvider(t, cacheDir, contents)
+
+	dir, err := p.fetchRequiredFiles(Repository{Name: "widget"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	readme, err := os.ReadFile(filepath.Join(dir, "README.md"))
+	if err != nil || string(readme) != "# widget" {
+		t.Fatalf("expected README.md to be fetched, got %q, err %v", readme, err)
+	}
+
+	chart, err := os.ReadFile(filepath.Join(dir, "charts", "widget", "Chart.yaml"))
+	if err != nil || string(chart) != "name: widget" {
+		t.Fatalf("expected the nested chart file to be fetched by recursing into the charts directory, got %q, err %v", chart, err)
+	}
+
+	if dir != filepath.Join(cacheDir, "acme", "widget", "deadbeef") {
+		t.Fatalf("expected the result to be cached under org/repo/sha, got %q", dir)
+	}
+}
+
+func TestFetchRequiredFiles_ReusesCacheOnSecondCall(t *testing.T) {
+	cacheDir := t.TempDir()
+	requests := 0
+	p := newFakeGitHubProvider(t, cacheDir, map[string]any{"README.md": fileEntry("README.md", "hi")})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/widget/commits/HEAD", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(&github.RepositoryCommit{SHA: github.String("deadbeef")})
+	})
+	contentRequests := 0
+	mux.HandleFunc("/repos/acme/widget/contents/", func(w http.ResponseWriter, r *http.Request) {
+		contentRequests++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	p.client.BaseURL, _ = url.Parse(server.URL + "/")
+
+	if _, err := p.fetchRequiredFiles(Repository{Name: "widget"}); err != nil {
+		t.Fatalf("unexpected error on first fetch: %v", err)
+	}
+	if _, err := p.fetchRequiredFiles(Repository{Name: "widget"}); err != nil {
+		t.Fatalf("unexpected error on second fetch: %v", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected the HEAD commit to still be resolved on both calls (cache is keyed by commit), got %d calls", requests)
+	}
+	if contentRequests != len(requiredCheckPaths()) {
+		t.Fatalf("expected contents to be fetched on the first call only and reused from the cache on the second, got %d contents requests", contentRequests)
+	}
+}
+
+func TestFetchRequiredFiles_DoesNotPersistCacheOnPartialFailure(t *testing.T) {
+	cacheDir := t.TempDir()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/acme/widget/commits/HEAD", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&github.RepositoryCommit{SHA: github.String("deadbeef")})
+	})
+	mux.HandleFunc("/repos/acme/widget/contents/", func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path[len("/repos/acme/widget/contents/"):]
+		if path == "README.md" {
+			json.NewEncoder(w).Encode(fileEntry("README.md", "# widget"))
+			return
+		}
+		// Every other required path fails with something other than a 404,
+		// simulating a transient API error rather than a legitimately
+		// missing file.
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, "boom")
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	p := &githubProvider{client: client, org: "acme", contentCacheDir: cacheDir}
+
+	if _, err := p.fetchRequiredFiles(Repository{Name: "widget"}); err == nil {
+		t.Fatal("expected an error when a required path fails to fetch")
+	}
+
+	dir := filepath.Join(cacheDir, "acme", "widget", "deadbeef")
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("expected no cache directory to be left behind after a partial failure, stat err: %v", err)
+	}
+	if _, err := os.Stat(dir + ".partial"); !os.IsNotExist(err) {
+		t.Fatalf("expected the partial directory to be cleaned up, stat err: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/tractusx"
+	"github.com/xanzy/go-gitlab"
+)
+
+// gitlabProvider lists, inspects and clones the projects of a GitLab group,
+// mirroring githubProvider for self-hosted or gitlab.com hosted mirrors of
+// Tractus-X repositories.
+type gitlabProvider struct {
+	client *gitlab.Client
+	group  string
+}
+
+// NewGitLabProvider creates a RepoProvider for all projects of group on the
+// GitLab instance reachable at baseURL. A token is read from the
+// GITLAB_ACCESS_TOKEN environment variable, if set.
+func NewGitLabProvider(baseURL, group string) (RepoProvider, error) {
+	client, err := gitlab.NewClient(os.Getenv("GITLAB_ACCESS_TOKEN"), gitlab.WithBaseURL(baseURL))
+	if err != nil {
+		return nil, fmt.Errorf("could not create GitLab client: %w", err)
+	}
+	return &gitlabProvider{client: client, group: group}, nil
+}
+
+func (p *gitlabProvider) List() ([]tractusx.Repository, error) {
+	var result []tractusx.Repository
+
+	opts := &gitlab.ListGroupProjectsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		projects, resp, err := p.client.Groups.ListGroupProjects(p.group, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not list projects of group %s: %w", p.group, err)
+		}
+
+		for _, project := range projects {
+			result = append(result, tractusx.Repository{Name: project.Name, Url: project.WebURL})
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return result, nil
+}
+
+func (p *gitlabProvider) FetchMetadata(repo tractusx.Repository) (*tractusx.Metadata, error) {
+	file, _, err := p.client.RepositoryFiles.GetRawFile(fmt.Sprintf("%s/%s", p.group, repo.Name), ".tractusx", &gitlab.GetRawFileOptions{})
+	if err != nil {
+		return nil, nil
+	}
+
+	metadata, err := tractusx.MetadataFromFile(file)
+	if err != nil {
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+func (p *gitlabProvider) Clone(repo Repository) (string, error) {
+	dir, err := os.MkdirTemp("", "tractusx-qc-*")
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.Command("git", "clone", repo.URL, dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("git clone %s failed: %w (%s)", repo.URL, err, out)
+	}
+
+	return dir, nil
+}
+
+func (p *gitlabProvider) Cleanup(dir string) error {
+	return os.RemoveAll(dir)
+}
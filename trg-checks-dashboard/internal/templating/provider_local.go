@@ -0,0 +1,85 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/tractusx"
+)
+
+// localProvider treats every immediate subdirectory of a root directory as a
+// repository. It is meant for offline or CI runs against repositories that
+// were checked out up front, without calling out to any forge API.
+type localProvider struct {
+	root string
+}
+
+// NewLocalProvider creates a RepoProvider backed by the repositories already
+// checked out under root, one directory per repository.
+func NewLocalProvider(root string) RepoProvider {
+	return &localProvider{root: root}
+}
+
+func (p *localProvider) List() ([]tractusx.Repository, error) {
+	entries, err := os.ReadDir(p.root)
+	if err != nil {
+		return nil, fmt.Errorf("could not read local repository root %s: %w", p.root, err)
+	}
+
+	var result []tractusx.Repository
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		result = append(result, tractusx.Repository{Name: entry.Name(), Url: filepath.Join(p.root, entry.Name())})
+	}
+	return result, nil
+}
+
+func (p *localProvider) FetchMetadata(repo tractusx.Repository) (*tractusx.Metadata, error) {
+	content, err := os.ReadFile(filepath.Join(repo.Url, ".tractusx"))
+	if err != nil {
+		return nil, nil
+	}
+
+	metadata, err := tractusx.MetadataFromFile(content)
+	if err != nil {
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+// Clone returns the repository's existing local directory unchanged; there is
+// nothing to fetch since the content is already on disk.
+func (p *localProvider) Clone(repo Repository) (string, error) {
+	if _, err := os.Stat(repo.URL); err != nil {
+		return "", fmt.Errorf("local repository %s not found: %w", repo.URL, err)
+	}
+	return repo.URL, nil
+}
+
+// Cleanup is a no-op: the directory belongs to the caller, not a temporary
+// checkout created by this provider.
+func (p *localProvider) Cleanup(dir string) error {
+	return nil
+}
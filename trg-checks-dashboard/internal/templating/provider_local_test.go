@@ -0,0 +1,115 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalProvider_ListReturnsOnlySubdirectories(t *testing.T) {
+	root := t.TempDir()
+	mustMkdir(t, filepath.Join(root, "repo-a"))
+	mustMkdir(t, filepath.Join(root, "repo-b"))
+	mustWriteFile(t, filepath.Join(root, "not-a-repo.txt"), "")
+
+	repos, err := NewLocalProvider(root).List()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repos) != 2 {
+		t.Fatalf("expected 2 repos (directories only), got %+v", repos)
+	}
+}
+
+func TestLocalProvider_FetchMetadataParsesTractusxFile(t *testing.T) {
+	root := t.TempDir()
+	repoDir := filepath.Join(root, "repo-a")
+	mustMkdir(t, repoDir)
+	mustWriteFile(t, filepath.Join(repoDir, ".tractusx"), "leadingRepository: repo-a\n")
+
+	p := NewLocalProvider(root)
+	repos, _ := p.List()
+
+	metadata, err := p.FetchMetadata(repos[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if metadata == nil {
+		t.Fatal("expected metadata to be parsed from .tractusx")
+	}
+}
+
+func TestLocalProvider_FetchMetadataReturnsNilWithoutError(t *testing.T) {
+	root := t.TempDir()
+	mustMkdir(t, filepath.Join(root, "repo-a"))
+
+	p := NewLocalProvider(root)
+	repos, _ := p.List()
+
+	metadata, err := p.FetchMetadata(repos[0])
+	if err != nil || metadata != nil {
+		t.Fatalf("expected (nil, nil) for a repo without .tractusx, got (%+v, %v)", metadata, err)
+	}
+}
+
+func TestLocalProvider_CloneReturnsExistingDirUnchanged(t *testing.T) {
+	root := t.TempDir()
+	repoDir := filepath.Join(root, "repo-a")
+	mustMkdir(t, repoDir)
+
+	p := NewLocalProvider(root)
+	dir, err := p.Clone(Repository{Name: "repo-a", URL: repoDir})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dir != repoDir {
+		t.Fatalf("expected Clone to return %q unchanged, got %q", repoDir, dir)
+	}
+
+	if err := p.Cleanup(dir); err != nil {
+		t.Fatalf("expected Cleanup to be a no-op, got error: %v", err)
+	}
+	if _, err := os.Stat(repoDir); err != nil {
+		t.Fatalf("expected Cleanup to leave the caller's directory alone, got: %v", err)
+	}
+}
+
+func TestLocalProvider_CloneErrorsWhenDirMissing(t *testing.T) {
+	p := NewLocalProvider(t.TempDir())
+	if _, err := p.Clone(Repository{Name: "missing", URL: "/no/such/dir"}); err == nil {
+		t.Fatal("expected an error for a repository directory that does not exist")
+	}
+}
+
+func mustMkdir(t *testing.T, dir string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("could not create %s: %v", dir, err)
+	}
+}
+
+func mustWriteFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("could not write %s: %v", path, err)
+	}
+}
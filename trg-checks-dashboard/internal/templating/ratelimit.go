@@ -0,0 +1,103 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// rateLimitTransport retries a request, sleeping between attempts, for as
+// long as the GitHub API's primary or secondary rate limit keeps being hit,
+// bounded by a total of maxSleep slept across all retries of the request.
+type rateLimitTransport struct {
+	base     http.RoundTripper
+	maxSleep time.Duration
+
+	// sleep defaults to time.Sleep; overridable by tests so they don't have
+	// to wait out real rate-limit windows.
+	sleep func(time.Duration)
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sleep := t.sleep
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+
+	remaining := t.maxSleep
+
+	for {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+
+		wait, limited := rateLimitSleepDuration(resp, remaining)
+		if !limited {
+			return resp, nil
+		}
+
+		resp.Body.Close()
+		if t.maxSleep > 0 {
+			if remaining <= 0 {
+				return resp, fmt.Errorf("GitHub rate limit still in effect for %s after sleeping up to the %s bound", req.URL, t.maxSleep)
+			}
+			remaining -= wait
+		}
+
+		log.Printf("GitHub rate limit hit for %s, sleeping %s before retrying", req.URL, wait)
+		sleep(wait)
+	}
+}
+
+// rateLimitSleepDuration inspects resp for signs of a primary rate limit
+// (X-RateLimit-Remaining: 0, reset time in X-RateLimit-Reset) or a secondary
+// rate limit (Retry-After), returning how long to sleep before retrying.
+func rateLimitSleepDuration(resp *http.Response, maxSleep time.Duration) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return clampSleep(time.Until(time.Unix(reset, 0)), maxSleep), true
+		}
+	}
+
+	if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return clampSleep(time.Duration(retryAfter)*time.Second, maxSleep), true
+	}
+
+	return 0, false
+}
+
+func clampSleep(sleep, maxSleep time.Duration) time.Duration {
+	if sleep < 0 {
+		return 0
+	}
+	if maxSleep > 0 && sleep > maxSleep {
+		return maxSleep
+	}
+	return sleep
+}
@@ -0,0 +1,152 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestClampSleep(t *testing.T) {
+	tests := []struct {
+		name     string
+		sleep    time.Duration
+		maxSleep time.Duration
+		want     time.Duration
+	}{
+		{"negative sleep clamps to zero", -time.Second, time.Minute, 0},
+		{"within bound is unchanged", 30 * time.Second, time.Minute, 30 * time.Second},
+		{"over bound clamps to max", 2 * time.Minute, time.Minute, time.Minute},
+		{"zero max means unbounded", 2 * time.Minute, 0, 2 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clampSleep(tt.sleep, tt.maxSleep); got != tt.want {
+				t.Errorf("clampSleep(%v, %v) = %v, want %v", tt.sleep, tt.maxSleep, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitSleepDuration(t *testing.T) {
+	t.Run("non rate-limit status is ignored", func(t *testing.T) {
+		resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+		if _, limited := rateLimitSleepDuration(resp, time.Minute); limited {
+			t.Fatal("expected a 200 response to not be treated as rate-limited")
+		}
+	})
+
+	t.Run("primary rate limit sleeps until reset, capped", func(t *testing.T) {
+		reset := time.Now().Add(5 * time.Minute)
+		resp := &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{strconv.FormatInt(reset.Unix(), 10)},
+			},
+		}
+
+		sleep, limited := rateLimitSleepDuration(resp, time.Minute)
+		if !limited {
+			t.Fatal("expected primary rate limit to be detected")
+		}
+		if sleep != time.Minute {
+			t.Errorf("expected sleep to be capped at 1m, got %v", sleep)
+		}
+	})
+
+	t.Run("secondary rate limit honors Retry-After", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{"Retry-After": []string{"30"}},
+		}
+
+		sleep, limited := rateLimitSleepDuration(resp, time.Minute)
+		if !limited {
+			t.Fatal("expected secondary rate limit to be detected")
+		}
+		if sleep != 30*time.Second {
+			t.Errorf("expected 30s sleep, got %v", sleep)
+		}
+	})
+
+	t.Run("403 without rate-limit headers is not rate-limited", func(t *testing.T) {
+		resp := &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}}
+		if _, limited := rateLimitSleepDuration(resp, time.Minute); limited {
+			t.Fatal("expected a plain 403 to not be treated as rate-limited")
+		}
+	})
+}
+
+func rateLimitedResponse(retryAfterSeconds string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{"Retry-After": []string{retryAfterSeconds}},
+		Body:       io.NopCloser(bytes.NewBuffer(nil)),
+	}
+}
+
+func TestRateLimitTransport_RetriesUntilNoLongerLimited(t *testing.T) {
+	attempts := 0
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts < 3 {
+			return rateLimitedResponse("0"), nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(nil))}, nil
+	})
+
+	transport := &rateLimitTransport{base: base, maxSleep: time.Minute, sleep: func(time.Duration) {}}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/repos/foo", nil)
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the eventual 200 to be returned, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts (2 rate-limited + 1 success), got %d", attempts)
+	}
+}
+
+func TestRateLimitTransport_GivesUpOnceMaxSleepIsExhausted(t *testing.T) {
+	attempts := 0
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return rateLimitedResponse("30"), nil
+	})
+
+	transport := &rateLimitTransport{base: base, maxSleep: 45 * time.Second, sleep: func(time.Duration) {}}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/repos/foo", nil)
+
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Fatal("expected an error once the sleep budget is exhausted")
+	}
+	if attempts != 3 {
+		t.Fatalf("expected exactly 3 attempts (30s then 15s of the 45s budget, then giving up), got %d", attempts)
+	}
+}
@@ -20,66 +20,34 @@
 package templating
 
 import (
-	"context"
+	"io/fs"
 	"log"
-	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/container"
 	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/docs"
 	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/helm"
 	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/repo"
 	"github.com/eclipse-tractusx/tractusx-quality-checks/pkg/tractusx"
-	"github.com/google/go-github/v53/github"
-	"golang.org/x/oauth2"
 )
 
-const gitHubOrg = "eclipse-tractusx"
-
-var gitHubClient *github.Client
-
-func CheckProducts() ([]CheckedProduct, []Repository) {
-	repoInfoByRepoUrl := make(map[string]repoInfo)
-	var unhandledRepos []Repository
-
-	repos := getOrgRepos()
-
-	for _, repo := range repos {
-		metadata := getMetadataForRepo(repo)
-
-		if metadata == nil {
-			unhandledRepos = append(unhandledRepos, Repository{Name: repo.Name, URL: repo.Url})
-		} else {
-			repoInfoByRepoUrl[repo.Url] = repoInfo{metadata: *metadata, repoName: repo.Name, repoUrl: repo.Url}
-		}
-	}
-
-	var checkedProducts []CheckedProduct
-	for _, p := range getProductsFromMetadata(repoInfoByRepoUrl) {
-		checkedProduct := CheckedProduct{Name: p.Name, LeadingRepo: p.LeadingRepo, OverallPassed: true}
-		for _, r := range p.Repositories {
-			checkedRepo := runQualityChecks(r)
-			checkedProduct.OverallPassed = checkedProduct.OverallPassed && checkedRepo.PassedAllGuidelines
-			checkedProduct.CheckedRepositories = append(checkedProduct.CheckedRepositories, checkedRepo)
-		}
-
-		checkedProducts = append(checkedProducts, checkedProduct)
-	}
-
-	return checkedProducts, unhandledRepos
-}
-
-func runQualityChecks(repo Repository) CheckedRepository {
+func runQualityChecks(repo Repository, provider RepoProvider, sink MetricsSink) CheckedRepository {
 	log.Printf("Starting checks for repo: %s", repo.Name)
 	checkedRepo := CheckedRepository{RepoUrl: repo.URL, RepoName: repo.Name, PassedAllGuidelines: true}
 
-	dir, err := cloneRepo(repo)
+	cloneStart := time.Now()
+	dir, err := provider.Clone(repo)
+	cloneDuration := time.Since(cloneStart)
 	if err != nil {
 		log.Printf("Could not clone repo %s. Error: %s", repo.URL, err)
 		return CheckedRepository{}
 	}
+	defer provider.Cleanup(dir)
 
+	checkStart := time.Now()
 	for _, check := range initializeChecksForDirectory(dir) {
 		testResult := check.Test()
 		checkedRepo.PassedAllGuidelines = checkedRepo.PassedAllGuidelines && (testResult.Passed || check.IsOptional())
@@ -91,14 +59,41 @@ func runQualityChecks(repo Repository) CheckedRepository {
 			GuidelineUrl:     check.ExternalDescription(),
 			GuidelineName:    check.Name(),
 		}
+		if locatable, ok := check.(LocatableGuideline); ok {
+			guidelineCheck.Locations = locatable.Locations()
+		}
 		checkedRepo.GuidelineChecks = append(checkedRepo.GuidelineChecks, guidelineCheck)
 	}
+	checkDuration := time.Since(checkStart)
+
+	sink.Report(RepoMetrics{
+		RepoName:      repo.Name,
+		CloneDuration: cloneDuration,
+		CheckDuration: checkDuration,
+		RepoSizeBytes: dirSize(dir),
+		Passed:        checkedRepo.PassedAllGuidelines,
+	})
 
-	// Cleanup temporary directory used to clone the repo.
-	defer os.RemoveAll(dir)
 	return checkedRepo
 }
 
+// dirSize sums the size in bytes of all regular files under dir. Errors
+// walking individual entries are ignored; a partial size is still useful as
+// a metric.
+func dirSize(dir string) int64 {
+	var size int64
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
 func initializeChecksForDirectory(dir string) []tractusx.QualityGuideline {
 	var checks []tractusx.QualityGuideline
 
@@ -113,6 +108,21 @@ func initializeChecksForDirectory(dir string) []tractusx.QualityGuideline {
 	return checks
 }
 
+// requiredCheckPaths lists the repository-relative paths needed by the
+// checks in initializeChecksForDirectory. Providers that can fetch
+// individual files instead of performing a full clone (see
+// githubProvider.fetchRequiredFiles) use this to know what to fetch.
+func requiredCheckPaths() []string {
+	return []string{
+		"README.md",
+		"INSTALL.md",
+		"CHANGELOG.md",
+		".tractusx",
+		"Dockerfile",
+		"charts",
+	}
+}
+
 func getProductsFromMetadata(metadataForRepo map[string]repoInfo) []Product {
 	log.Println("Forming products from repo metadata")
 
@@ -144,80 +154,3 @@ func getProductsFromMetadata(metadataForRepo map[string]repoInfo) []Product {
 	})
 	return products
 }
-
-type listFunc[T any] func(ctx context.Context, options *github.ListOptions) ([]T, *github.Response, error)
-
-func paginate[T any](ctx context.Context, listFunc listFunc[T], listOps *github.ListOptions) ([]T, error) {
-	var allItems []T
-
-	for {
-		items, resp, err := listFunc(ctx, listOps)
-		if err != nil {
-			return allItems, err
-		}
-
-		allItems = append(allItems, items...)
-
-		if resp.NextPage == 0 {
-			break
-		}
-
-		listOps.Page = resp.NextPage
-	}
-
-	return allItems, nil
-}
-
-func listOrgRepos(ctx context.Context, listOps *github.ListOptions) ([]*github.Repository, *github.Response, error) {
-	repos, response, err := gitHubClient.Repositories.ListByOrg(ctx, gitHubOrg, &github.RepositoryListByOrgOptions{
-		Type:        "public",
-		ListOptions: *listOps})
-	return repos, response, err
-}
-
-func getOrgRepos() []tractusx.Repository {
-	repos, err := paginate(context.Background(), listOrgRepos, &github.ListOptions{
-		Page:    0,
-		PerPage: 100,
-	})
-
-	log.Printf("%s", repos)
-
-	if err != nil {
-		log.Printf("Could not query repositories for GitHub organization: %v", err)
-	}
-
-	var result []tractusx.Repository
-	for _, r := range repos {
-		result = append(result, tractusx.Repository{Name: *r.Name, Url: *r.HTMLURL})
-	}
-	return result
-}
-
-func getMetadataForRepo(repo tractusx.Repository) *tractusx.Metadata {
-	log.Printf("Getting tractusx metadata for repository: %s", repo.Name)
-	contents, _, _, err := gitHubClient.Repositories.GetContents(context.Background(), gitHubOrg, repo.Name, ".tractusx", nil)
-	if err != nil {
-		log.Printf("Could not get .tractusx metadata for repository: %s", repo.Name)
-		return nil
-	}
-
-	content, _ := contents.GetContent()
-	metadata, err := tractusx.MetadataFromFile([]byte(content))
-	if err != nil {
-		log.Printf("Could not parse .tractusx metadata for repository: %s", repo.Name)
-		return nil
-	}
-	return metadata
-}
-
-func init() {
-	if os.Getenv("GITHUB_ACCESS_TOKEN") == "" {
-		gitHubClient = github.NewClient(nil)
-	} else {
-		httpClient := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: os.Getenv("GITHUB_ACCESS_TOKEN")},
-		))
-		gitHubClient = github.NewClient(httpClient)
-	}
-}
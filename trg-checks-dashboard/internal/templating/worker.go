@@ -0,0 +1,50 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"runtime"
+	"sync"
+)
+
+// runConcurrently runs run for every item in items, with at most
+// maxConcurrency (runtime.NumCPU() when zero or negative) in flight at once,
+// and returns one result per item in the same order as items.
+func runConcurrently[T any, R any](items []T, maxConcurrency int, run func(T) R) []R {
+	if maxConcurrency <= 0 {
+		maxConcurrency = runtime.NumCPU()
+	}
+
+	results := make([]R, len(items))
+	semaphore := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, item T) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			results[i] = run(item)
+		}(i, item)
+	}
+	wg.Wait()
+
+	return results
+}
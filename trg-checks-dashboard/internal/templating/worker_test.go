@@ -0,0 +1,125 @@
+/*******************************************************************************
+ * Copyright (c) 2023 Contributors to the Eclipse Foundation
+ *
+ * See the NOTICE file(s) distributed with this work for additional
+ * information regarding copyright ownership.
+ *
+ * This program and the accompanying materials are made available under the
+ * terms of the Apache License, Version 2.0 which is available at
+ * https://www.apache.org/licenses/LICENSE-2.0.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+ * License for the specific language governing permissions and limitations
+ * under the License.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ ******************************************************************************/
+
+package templating
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunConcurrently_PreservesResultOrder(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	results := runConcurrently(items, 3, func(i int) int {
+		time.Sleep(time.Duration(10-i) * time.Millisecond)
+		return i * i
+	})
+
+	for i, want := range items {
+		if results[i] != want*want {
+			t.Fatalf("results[%d] = %d, want %d (order must match items, not completion order)", i, results[i], want*want)
+		}
+	}
+}
+
+func TestRunConcurrently_NeverExceedsMaxConcurrency(t *testing.T) {
+	const maxConcurrency = 4
+	items := make([]int, 50)
+
+	var current, peak int64
+	runConcurrently(items, maxConcurrency, func(int) int {
+		n := atomic.AddInt64(&current, 1)
+		for {
+			p := atomic.LoadInt64(&peak)
+			if n <= p || atomic.CompareAndSwapInt64(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt64(&current, -1)
+		return 0
+	})
+
+	if peak > maxConcurrency {
+		t.Fatalf("expected at most %d goroutines running at once, observed %d", maxConcurrency, peak)
+	}
+}
+
+func TestRunConcurrently_DefaultsToNumCPUWhenUnbounded(t *testing.T) {
+	items := []int{1, 2, 3}
+	results := runConcurrently(items, 0, func(i int) int { return i + 1 })
+	if len(results) != len(items) {
+		t.Fatalf("expected %d results, got %d", len(items), len(results))
+	}
+	for i, want := range []int{2, 3, 4} {
+		if results[i] != want {
+			t.Fatalf("results[%d] = %d, want %d", i, results[i], want)
+		}
+	}
+}
+
+func TestAggregateCheckedRepos_GroupsResultsByProductAndANDsPassed(t *testing.T) {
+	products := []Product{
+		{Name: "product-a", LeadingRepo: "repo-a1"},
+		{Name: "product-b", LeadingRepo: "repo-b1"},
+	}
+	productIndexes := []int{0, 0, 1}
+	results := []CheckedRepository{
+		{RepoName: "repo-a1", PassedAllGuidelines: true},
+		{RepoName: "repo-a2", PassedAllGuidelines: false},
+		{RepoName: "repo-b1", PassedAllGuidelines: true},
+	}
+
+	checkedProducts := aggregateCheckedRepos(products, productIndexes, results)
+
+	if len(checkedProducts) != 2 {
+		t.Fatalf("expected 2 checked products, got %d", len(checkedProducts))
+	}
+
+	a := checkedProducts[0]
+	if len(a.CheckedRepositories) != 2 {
+		t.Fatalf("expected product-a to have 2 checked repositories, got %d", len(a.CheckedRepositories))
+	}
+	if a.OverallPassed {
+		t.Error("expected product-a to fail overall because repo-a2 failed")
+	}
+
+	b := checkedProducts[1]
+	if len(b.CheckedRepositories) != 1 || b.CheckedRepositories[0].RepoName != "repo-b1" {
+		t.Fatalf("expected product-b to have only repo-b1, got %+v", b.CheckedRepositories)
+	}
+	if !b.OverallPassed {
+		t.Error("expected product-b to pass overall since its only repo passed")
+	}
+}
+
+func TestAggregateCheckedRepos_NoRepositoriesStillPassesOverall(t *testing.T) {
+	products := []Product{{Name: "product-a", LeadingRepo: "repo-a1"}}
+
+	checkedProducts := aggregateCheckedRepos(products, nil, nil)
+
+	if len(checkedProducts) != 1 {
+		t.Fatalf("expected 1 checked product, got %d", len(checkedProducts))
+	}
+	if !checkedProducts[0].OverallPassed {
+		t.Error("expected a product with no jobs to default to OverallPassed")
+	}
+}